@@ -0,0 +1,183 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/array"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRechunkArraysConsistentlyWithOptions(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	newGroup := func(lens ...int) []arrow.Array {
+		var arrs []arrow.Array
+		var v int64
+		for _, n := range lens {
+			vals := make([]int64, n)
+			for i := range vals {
+				vals[i] = v
+				v++
+			}
+			arrs = append(arrs, ArrayFromSlice(mem, vals))
+		}
+		return arrs
+	}
+
+	t.Run("no options preserves zero-copy slices spanning a whole array", func(t *testing.T) {
+		a := newGroup(3, 5)
+		b := newGroup(8)
+		defer func() {
+			for _, arr := range a {
+				arr.Release()
+			}
+			for _, arr := range b {
+				arr.Release()
+			}
+		}()
+
+		rechunked := RechunkArraysConsistently([][]arrow.Array{a, b})
+		require.Len(t, rechunked[0], 2)
+		assert.Same(t, a[0], rechunked[0][0])
+		assert.Same(t, a[1], rechunked[0][1])
+		for _, g := range rechunked {
+			for _, arr := range g {
+				arr.Release()
+			}
+		}
+	})
+
+	t.Run("MaxChunkSize caps chunk length", func(t *testing.T) {
+		a := newGroup(8)
+		defer a[0].Release()
+
+		rechunked, err := RechunkArraysConsistentlyWithOptions(mem, [][]arrow.Array{a, newGroup(8)}, RechunkOptions{MaxChunkSize: 3})
+		require.NoError(t, err)
+		var lens []int
+		for _, arr := range rechunked[0] {
+			lens = append(lens, arr.Len())
+		}
+		assert.Equal(t, []int{3, 3, 2}, lens)
+		releaseGroups(rechunked)
+	})
+
+	t.Run("Alignment realigns interior split points", func(t *testing.T) {
+		// two input arrays split at 5; aligning to 4 pulls the first
+		// split back to 4. The input boundary at 5 still forces a split
+		// there, so a 1-element chunk sits between the two.
+		groups := [][]arrow.Array{newGroup(5, 3), newGroup(8)}
+		rechunked, err := RechunkArraysConsistentlyWithOptions(mem, groups, RechunkOptions{Alignment: 4})
+		require.NoError(t, err)
+		var lens []int
+		for _, arr := range rechunked[0] {
+			lens = append(lens, arr.Len())
+		}
+		assert.Equal(t, []int{4, 1, 3}, lens)
+		releaseGroups(rechunked)
+	})
+}
+
+func TestRechunkChunked(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	a1 := ArrayFromSlice(mem, []int64{1, 2, 3})
+	a2 := ArrayFromSlice(mem, []int64{4, 5})
+	defer a1.Release()
+	defer a2.Release()
+
+	chunked := arrow.NewChunked(arrow.PrimitiveTypes.Int64, []arrow.Array{a1, a2})
+	defer chunked.Release()
+
+	out, err := RechunkChunked(mem, []*arrow.Chunked{chunked}, RechunkOptions{})
+	require.NoError(t, err)
+	defer out[0].Release()
+
+	assert.Equal(t, 5, out[0].Len())
+}
+
+func newStringArray(mem memory.Allocator, values []string) arrow.Array {
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+
+	bldr.AppendValues(values, nil)
+	return bldr.NewArray()
+}
+
+func TestUnifyDictionaries(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	dictTyp := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+
+	newDict := func(values []string, dict []string) arrow.Array {
+		idx := ArrayFromSlice(mem, toInt8(values, dict))
+		defer idx.Release()
+		dictArr := newStringArray(mem, dict)
+		defer dictArr.Release()
+		return array.NewDictionaryArray(dictTyp, idx, dictArr)
+	}
+
+	chunkA := newDict([]string{"a", "b", "a"}, []string{"a", "b"})
+	chunkB := newDict([]string{"c", "b"}, []string{"b", "c"})
+	defer chunkA.Release()
+	defer chunkB.Release()
+
+	unified, changed, err := unifyDictionaries(mem, []arrow.Array{chunkA, chunkB})
+	require.NoError(t, err)
+	require.True(t, changed)
+	defer releaseGroups([][]arrow.Array{unified})
+
+	require.Len(t, unified, 2)
+	dictA := unified[0].(*array.Dictionary)
+	dictB := unified[1].(*array.Dictionary)
+	assert.Equal(t, dictA.Dictionary().Data(), dictB.Dictionary().Data())
+	assert.Equal(t, 3, dictA.Len())
+	assert.Equal(t, 2, dictB.Len())
+}
+
+func TestUnifyDictionariesPreservesOrdered(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	dictTyp := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String, Ordered: true}
+
+	idx := ArrayFromSlice(mem, toInt8([]string{"a", "b"}, []string{"a", "b"}))
+	defer idx.Release()
+	dict := newStringArray(mem, []string{"a", "b"})
+	defer dict.Release()
+	chunk := array.NewDictionaryArray(dictTyp, idx, dict)
+	defer chunk.Release()
+
+	unified, changed, err := unifyDictionaries(mem, []arrow.Array{chunk})
+	require.NoError(t, err)
+	require.True(t, changed)
+	defer releaseGroups([][]arrow.Array{unified})
+
+	assert.True(t, unified[0].DataType().(*arrow.DictionaryType).Ordered)
+}
+
+func toInt8(values, dict []string) []int8 {
+	pos := make(map[string]int8, len(dict))
+	for i, v := range dict {
+		pos[v] = int8(i)
+	}
+	out := make([]int8, len(values))
+	for i, v := range values {
+		out[i] = pos[v]
+	}
+	return out
+}
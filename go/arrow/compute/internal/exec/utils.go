@@ -186,9 +186,32 @@ func ArrayFromSlice[T NumericTypes](mem memory.Allocator, data []T) arrow.Array
 	return bldr.NewArray()
 }
 
+// RechunkOptions controls chunk size, alignment, and dictionary
+// unification for RechunkArraysConsistentlyWithOptions/RechunkChunked.
+type RechunkOptions struct {
+	// MaxChunkSize caps the length of any produced chunk. Zero means no cap.
+	MaxChunkSize int64
+	// Alignment, if > 1, biases split points back to the nearest
+	// preceding multiple of Alignment elements (e.g. a SIMD lane count).
+	Alignment int64
+	// UnifyDictionaries unifies dictionaries across a group's produced
+	// chunks instead of leaving each with its source array's dictionary.
+	UnifyDictionaries bool
+}
+
+// RechunkArraysConsistently is RechunkArraysConsistentlyWithOptions with
+// the zero-value RechunkOptions.
 func RechunkArraysConsistently(groups [][]arrow.Array) [][]arrow.Array {
-	if len(groups) <= 1 {
-		return groups
+	rechunked, _ := RechunkArraysConsistentlyWithOptions(nil, groups, RechunkOptions{})
+	return rechunked
+}
+
+// RechunkArraysConsistentlyWithOptions is RechunkArraysConsistently with
+// MaxChunkSize/Alignment/UnifyDictionaries support. mem is only used
+// when opts.UnifyDictionaries is set.
+func RechunkArraysConsistentlyWithOptions(mem memory.Allocator, groups [][]arrow.Array, opts RechunkOptions) ([][]arrow.Array, error) {
+	if len(groups) == 0 || (len(groups) == 1 && opts == (RechunkOptions{})) {
+		return groups, nil
 	}
 
 	var totalLen int
@@ -197,7 +220,7 @@ func RechunkArraysConsistently(groups [][]arrow.Array) [][]arrow.Array {
 	}
 
 	if totalLen == 0 {
-		return groups
+		return groups, nil
 	}
 
 	rechunked := make([][]arrow.Array, len(groups))
@@ -221,6 +244,8 @@ func RechunkArraysConsistently(groups [][]arrow.Array) [][]arrow.Array {
 			groups[i] = g
 		}
 
+		chunkLength = clampChunkLength(chunkLength, start, int64(totalLen), opts)
+
 		// now slice all the arrays along this chunk size
 		for i, g := range groups {
 			offset := offsets[i]
@@ -237,7 +262,184 @@ func RechunkArraysConsistently(groups [][]arrow.Array) [][]arrow.Array {
 
 		start += int64(chunkLength)
 	}
-	return rechunked
+
+	if opts.UnifyDictionaries {
+		for i, g := range rechunked {
+			unified, changed, err := unifyDictionaries(mem, g)
+			if err != nil {
+				releaseGroups(rechunked)
+				return nil, err
+			}
+			if changed {
+				for _, c := range g {
+					c.Release()
+				}
+				rechunked[i] = unified
+			}
+		}
+	}
+
+	return rechunked, nil
+}
+
+// releaseGroups releases every chunk of every group.
+func releaseGroups(groups [][]arrow.Array) {
+	for _, g := range groups {
+		for _, c := range g {
+			c.Release()
+		}
+	}
+}
+
+// clampChunkLength applies opts.MaxChunkSize and opts.Alignment to a
+// split point at [start, start+chunkLength).
+func clampChunkLength(chunkLength int, start, totalLen int64, opts RechunkOptions) int {
+	if opts.MaxChunkSize > 0 && int64(chunkLength) > opts.MaxChunkSize {
+		chunkLength = int(opts.MaxChunkSize)
+	}
+
+	if opts.Alignment > 1 {
+		// only realign a split that falls strictly inside the input, so
+		// the final chunk still covers the whole remaining length.
+		if end := start + int64(chunkLength); end < totalLen {
+			if aligned := (end / opts.Alignment) * opts.Alignment; aligned > start {
+				chunkLength = int(aligned - start)
+			}
+		}
+	}
+
+	return chunkLength
+}
+
+// RechunkChunked is the *arrow.Chunked analogue of
+// RechunkArraysConsistentlyWithOptions, for rechunking several
+// ChunkedArrays (e.g. a table's columns) together.
+func RechunkChunked(mem memory.Allocator, chunked []*arrow.Chunked, opts RechunkOptions) ([]*arrow.Chunked, error) {
+	groups := make([][]arrow.Array, len(chunked))
+	for i, c := range chunked {
+		groups[i] = c.Chunks()
+	}
+
+	rechunked, err := RechunkArraysConsistentlyWithOptions(mem, groups, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// when there is nothing to rechunk, groups is handed back as-is
+	// without new references, so only release chunks that were produced.
+	produced := len(groups) == 0 || &rechunked[0] != &groups[0]
+	out := make([]*arrow.Chunked, len(rechunked))
+	for i, chunks := range rechunked {
+		out[i] = arrow.NewChunked(chunked[i].DataType(), chunks)
+		if produced {
+			for _, c := range chunks {
+				c.Release()
+			}
+		}
+	}
+	return out, nil
+}
+
+// unifyDictionaries rewrites a dictionary-encoded group's chunks to
+// share one dictionary, with indices transposed to match. Non
+// dictionary-encoded chunks are returned unchanged with changed=false.
+func unifyDictionaries(mem memory.Allocator, chunks []arrow.Array) (unified []arrow.Array, changed bool, err error) {
+	if len(chunks) == 0 {
+		return chunks, false, nil
+	}
+
+	dictTyp, ok := chunks[0].DataType().(*arrow.DictionaryType)
+	if !ok {
+		return chunks, false, nil
+	}
+
+	unifier, err := array.NewDictionaryUnifier(mem, dictTyp.ValueType)
+	if err != nil {
+		return nil, false, err
+	}
+	defer unifier.Release()
+
+	mappings := make([][]int32, len(chunks))
+	for i, c := range chunks {
+		transposeMap, err := unifier.UnifyAndTranspose(c.(*array.Dictionary).Dictionary())
+		if err != nil {
+			return nil, false, err
+		}
+		mappings[i] = append([]int32(nil), arrow.Int32Traits.CastFromBytes(transposeMap.Bytes())...)
+		transposeMap.Release()
+	}
+
+	resultTyp, unifiedDict, err := unifier.GetResult()
+	if err != nil {
+		return nil, false, err
+	}
+	defer unifiedDict.Release()
+
+	// the unified dictionary may hold more values than any single input
+	// dictionary, so the unifier may have had to widen the index type
+	// (e.g. int8 -> int16) to be able to address all of them. GetResult
+	// doesn't preserve Ordered, so carry it over from the input type.
+	unifiedTyp, ok := resultTyp.(*arrow.DictionaryType)
+	if !ok {
+		return nil, false, fmt.Errorf("%w: unexpected dictionary unifier result type %s", arrow.ErrInvalid, resultTyp)
+	}
+	outTyp := &arrow.DictionaryType{IndexType: unifiedTyp.IndexType, ValueType: dictTyp.ValueType, Ordered: dictTyp.Ordered}
+
+	out := make([]arrow.Array, len(chunks))
+	for i, c := range chunks {
+		dict := c.(*array.Dictionary)
+		indices, err := rewriteDictionaryIndices(mem, dict.Indices(), mappings[i], outTyp.IndexType)
+		if err != nil {
+			for _, done := range out[:i] {
+				done.Release()
+			}
+			return nil, false, err
+		}
+		unifiedDict.Retain()
+		out[i] = array.NewDictionaryArray(outTyp, indices, unifiedDict)
+		indices.Release()
+	}
+
+	return out, true, nil
+}
+
+// rewriteDictionaryIndices builds a new indices array of type indexType,
+// applying mapping[oldIndex] to every non-null value of indices.
+func rewriteDictionaryIndices(mem memory.Allocator, indices arrow.Array, mapping []int32, indexType arrow.DataType) (arrow.Array, error) {
+	bldr := array.NewBuilder(mem, indexType)
+	defer bldr.Release()
+	bldr.Reserve(indices.Len())
+
+	switch b := bldr.(type) {
+	case *array.Int8Builder:
+		appendMappedIndices[int8](b, GetValues[int8](indices.Data(), 1), indices, mapping)
+	case *array.Int16Builder:
+		appendMappedIndices[int16](b, GetValues[int16](indices.Data(), 1), indices, mapping)
+	case *array.Int32Builder:
+		appendMappedIndices[int32](b, GetValues[int32](indices.Data(), 1), indices, mapping)
+	case *array.Int64Builder:
+		appendMappedIndices[int64](b, GetValues[int64](indices.Data(), 1), indices, mapping)
+	default:
+		return nil, fmt.Errorf("%w: unsupported dictionary index type %s", arrow.ErrInvalid, indexType)
+	}
+
+	return bldr.NewArray(), nil
+}
+
+type arrayIntBuilder[T IntTypes] interface {
+	array.Builder
+	Append(T)
+	AppendNull()
+}
+
+func appendMappedIndices[T IntTypes](bldr arrayIntBuilder[T], values []T, indices arrow.Array, mapping []int32) {
+	for i := 0; i < indices.Len(); i++ {
+		if indices.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		bldr.Append(T(mapping[values[i]]))
+	}
 }
 
 type ChunkResolver struct {
@@ -283,3 +485,83 @@ func (c *ChunkResolver) Resolve(idx int64) (chunk, index int64) {
 	atomic.StoreInt64(&c.cached, chunk)
 	return
 }
+
+// ResolveMany is like Resolve, batched: it writes the chunk index for
+// indices[i] to outChunks[i] and the within-chunk index to
+// outOffsets[i]. If indices is sorted, a single cursor is walked
+// forward through c.offsets instead of a binary search per index.
+func (c *ChunkResolver) ResolveMany(indices []int64, outChunks, outOffsets []int64) {
+	resolveMany(c, indices, outChunks, outOffsets)
+}
+
+// ResolveManyInt32 is ResolveMany for int32 indices.
+func (c *ChunkResolver) ResolveManyInt32(indices []int32, outChunks, outOffsets []int64) {
+	resolveMany(c, indices, outChunks, outOffsets)
+}
+
+func resolveMany[T int32 | int64](c *ChunkResolver, indices []T, outChunks, outOffsets []int64) {
+	if len(c.offsets) <= 1 {
+		for i, idx := range indices {
+			outChunks[i] = 0
+			outOffsets[i] = int64(idx)
+		}
+		return
+	}
+
+	var (
+		cur           = atomic.LoadInt64(&c.cached)
+		cached        = cur
+		sorted        = true
+		prevIdx int64 = -1
+	)
+
+	for i, rawIdx := range indices {
+		idx := int64(rawIdx)
+		if sorted {
+			if idx >= prevIdx {
+				// cur carries over from the previous call, which may have
+				// left it past idx (e.g. a fresh batch starting over at
+				// the beginning of the chunks); walk it back first.
+				for idx < c.offsets[cur] {
+					cur--
+				}
+				for idx >= c.offsets[cur+1] {
+					cur++
+				}
+				outChunks[i] = cur
+				outOffsets[i] = idx - c.offsets[cur]
+				prevIdx = idx
+				continue
+			}
+			// indices turned out not to be sorted after all; switch to
+			// the cache-based strategy below for the remainder, picking
+			// up from the last chunk we resolved.
+			sorted = false
+			cached = cur
+		}
+
+		cacheHit := idx >= c.offsets[cached] && idx < c.offsets[cached+1]
+		if !cacheHit && cached+2 < int64(len(c.offsets)) && idx >= c.offsets[cached+1] && idx < c.offsets[cached+2] {
+			// cheap check for the common case of the next index simply
+			// falling into the following chunk, before paying for a
+			// binary search.
+			cached++
+			cacheHit = true
+		}
+		if !cacheHit {
+			chkIdx, found := slices.BinarySearch(c.offsets, idx)
+			if !found {
+				chkIdx--
+			}
+			cached = int64(chkIdx)
+		}
+		outChunks[i] = cached
+		outOffsets[i] = idx - c.offsets[cached]
+	}
+
+	if sorted {
+		atomic.StoreInt64(&c.cached, cur)
+	} else {
+		atomic.StoreInt64(&c.cached, cached)
+	}
+}
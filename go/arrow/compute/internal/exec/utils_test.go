@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v10/arrow"
+	"github.com/apache/arrow/go/v10/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkResolverResolveMany(t *testing.T) {
+	mem := memory.NewGoAllocator()
+	chunks := []arrow.Array{
+		ArrayFromSlice(mem, []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}),
+		ArrayFromSlice(mem, []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}),
+		ArrayFromSlice(mem, []int64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}),
+	}
+	for _, c := range chunks {
+		defer c.Release()
+	}
+
+	newResolved := func() ([]int64, []int64) {
+		r := NewChunkResolver(chunks)
+		indices := []int64{0, 5, 9, 10, 15, 29}
+		outChunks := make([]int64, len(indices))
+		outOffsets := make([]int64, len(indices))
+		r.ResolveMany(indices, outChunks, outOffsets)
+		return outChunks, outOffsets
+	}
+
+	t.Run("sorted matches Resolve", func(t *testing.T) {
+		r := NewChunkResolver(chunks)
+		indices := []int64{0, 5, 9, 10, 15, 29}
+		wantChunks := make([]int64, len(indices))
+		wantOffsets := make([]int64, len(indices))
+		for i, idx := range indices {
+			wantChunks[i], wantOffsets[i] = r.Resolve(idx)
+		}
+
+		gotChunks, gotOffsets := newResolved()
+		assert.Equal(t, wantChunks, gotChunks)
+		assert.Equal(t, wantOffsets, gotOffsets)
+	})
+
+	t.Run("unsorted matches Resolve", func(t *testing.T) {
+		r := NewChunkResolver(chunks)
+		indices := []int64{25, 3, 22, 1, 11}
+		wantChunks := make([]int64, len(indices))
+		wantOffsets := make([]int64, len(indices))
+		for i, idx := range indices {
+			wantChunks[i], wantOffsets[i] = r.Resolve(idx)
+		}
+
+		r2 := NewChunkResolver(chunks)
+		gotChunks := make([]int64, len(indices))
+		gotOffsets := make([]int64, len(indices))
+		r2.ResolveMany(indices, gotChunks, gotOffsets)
+		assert.Equal(t, wantChunks, gotChunks)
+		assert.Equal(t, wantOffsets, gotOffsets)
+	})
+
+	t.Run("reused resolver, next batch starts earlier than cached chunk", func(t *testing.T) {
+		r := NewChunkResolver(chunks)
+		// prime the single-entry cache on chunk 2, as a prior call would.
+		r.Resolve(25)
+
+		indices := []int64{5, 6, 7}
+		gotChunks := make([]int64, len(indices))
+		gotOffsets := make([]int64, len(indices))
+		r.ResolveMany(indices, gotChunks, gotOffsets)
+
+		assert.Equal(t, []int64{0, 0, 0}, gotChunks)
+		assert.Equal(t, []int64{5, 6, 7}, gotOffsets)
+	})
+
+	t.Run("int32 indices", func(t *testing.T) {
+		r := NewChunkResolver(chunks)
+		indices := []int32{0, 5, 9, 10, 15, 29}
+		gotChunks := make([]int64, len(indices))
+		gotOffsets := make([]int64, len(indices))
+		r.ResolveManyInt32(indices, gotChunks, gotOffsets)
+		assert.Equal(t, []int64{0, 0, 0, 1, 1, 2}, gotChunks)
+		assert.Equal(t, []int64{0, 5, 9, 0, 5, 9}, gotOffsets)
+	})
+}